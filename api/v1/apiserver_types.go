@@ -30,6 +30,41 @@ type APIServerSpec struct {
 	// used in conjunction with ControlPlaneNodeSelector or ControlPlaneTolerations, then these overrides
 	// take precedence.
 	APIServerDeployment *APIServerDeployment `json:"apiServerDeployment,omitempty"`
+
+	// AuditLogging configures audit logging for the Tigera API server. It is only applicable to
+	// Calico Enterprise.
+	// +optional
+	AuditLogging *APIServerAuditLogging `json:"auditLogging,omitempty"`
+}
+
+// APIServerAuditLogOutput specifies where the Tigera API server writes its audit logs.
+// +kubebuilder:validation:Enum=HostPath;EmptyDir;Disabled
+type APIServerAuditLogOutput string
+
+const (
+	// APIServerAuditLogOutputHostPath writes audit logs to /var/log/calico/audit on the host, where they are
+	// collected by fluentd.
+	APIServerAuditLogOutputHostPath APIServerAuditLogOutput = "HostPath"
+
+	// APIServerAuditLogOutputEmptyDir writes audit logs to an emptyDir volume in the pod. This is useful on
+	// platforms that do not permit hostPath volumes, at the cost of the logs not being collected by fluentd.
+	APIServerAuditLogOutputEmptyDir APIServerAuditLogOutput = "EmptyDir"
+
+	// APIServerAuditLogOutputDisabled turns off audit logging.
+	APIServerAuditLogOutputDisabled APIServerAuditLogOutput = "Disabled"
+)
+
+// APIServerAuditLogging configures audit logging for the Tigera API server.
+type APIServerAuditLogging struct {
+	// PolicyConfigMapName is the name of a ConfigMap in the tigera-operator namespace that holds an
+	// audit.k8s.io/v1 Policy under the "config" key. If specified, it replaces the default audit policy.
+	// +optional
+	PolicyConfigMapName string `json:"policyConfigMapName,omitempty"`
+
+	// Output specifies where audit logs are written.
+	// Default: HostPath
+	// +optional
+	Output *APIServerAuditLogOutput `json:"output,omitempty"`
 }
 
 // APIServerStatus defines the observed state of Tigera API server.
@@ -56,6 +56,26 @@ func (in *APIServer) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerAuditLogging) DeepCopyInto(out *APIServerAuditLogging) {
+	*out = *in
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(APIServerAuditLogOutput)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerAuditLogging.
+func (in *APIServerAuditLogging) DeepCopy() *APIServerAuditLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerAuditLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIServerDeployment) DeepCopyInto(out *APIServerDeployment) {
 	*out = *in
@@ -266,6 +286,11 @@ func (in *APIServerSpec) DeepCopyInto(out *APIServerSpec) {
 		*out = new(APIServerDeployment)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AuditLogging != nil {
+		in, out := &in.AuditLogging, &out.AuditLogging
+		*out = new(APIServerAuditLogging)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerSpec.
@@ -105,6 +105,8 @@ func main() {
 	var sgSetup bool
 	var manageCRDs bool
 	var preDelete bool
+	var esRequestTimeout time.Duration
+	var esRequestRetries int
 
 	flag.BoolVar(&enableLeaderElection, "enable-leader-election", true,
 		"Enable leader election for controller manager. "+
@@ -125,6 +127,10 @@ func main() {
 		"Operator should manage the projectcalico.org and operator.tigera.io CRDs.")
 	flag.BoolVar(&preDelete, "pre-delete", false,
 		"Run helm pre-deletion hook logic, then exit.")
+	flag.DurationVar(&esRequestTimeout, "elasticsearch-request-timeout", utils.ElasticRequestTimeout,
+		"Timeout for requests the operator makes to Elasticsearch. 0 means no timeout.")
+	flag.IntVar(&esRequestRetries, "elasticsearch-request-retries", utils.ElasticConnRetries,
+		"Number of attempts the operator makes at each Elasticsearch request that fails to connect or gets a gateway error.")
 
 	opts := zap.Options{}
 	opts.BindFlags(flag.CommandLine)
@@ -454,6 +460,8 @@ func main() {
 		ShutdownContext:     ctx,
 		MultiTenant:         multiTenant,
 		ElasticExternal:     utils.UseExternalElastic(bootConfig),
+		ESRequestTimeout:    esRequestTimeout,
+		ESRequestRetries:    esRequestRetries,
 	}
 
 	// Before we start any controllers, make sure our options are valid.
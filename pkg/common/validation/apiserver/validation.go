@@ -15,7 +15,11 @@
 package validation
 
 import (
+	"fmt"
+
 	corev1 "k8s.io/api/core/v1"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+	"sigs.k8s.io/yaml"
 
 	"github.com/tigera/operator/pkg/common/k8svalidation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -32,3 +36,27 @@ func ValidateAPIServerDeploymentInitContainer(container corev1.Container) error
 	errs := k8svalidation.ValidateResourceRequirements(&container.Resources, field.NewPath("spec", "template", "spec", "initContainers"))
 	return errs.ToAggregate()
 }
+
+// ValidateAuditPolicy validates the given string is a well-formed audit.k8s.io/v1 Policy.
+func ValidateAuditPolicy(policy string) error {
+	if policy == "" {
+		return fmt.Errorf("audit policy is empty")
+	}
+
+	p := auditv1.Policy{}
+	if err := yaml.UnmarshalStrict([]byte(policy), &p); err != nil {
+		return fmt.Errorf("audit policy could not be parsed: %w", err)
+	}
+	if p.APIVersion != auditv1.SchemeGroupVersion.String() || p.Kind != "Policy" {
+		return fmt.Errorf("audit policy must have apiVersion %s and kind Policy, got apiVersion %q and kind %q",
+			auditv1.SchemeGroupVersion.String(), p.APIVersion, p.Kind)
+	}
+	for i, rule := range p.Rules {
+		switch rule.Level {
+		case auditv1.LevelNone, auditv1.LevelMetadata, auditv1.LevelRequest, auditv1.LevelRequestResponse:
+		default:
+			return fmt.Errorf("audit policy rule %d has invalid level %q", i, rule.Level)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ValidateAuditPolicy", func() {
+	DescribeTable("should reject invalid audit policies",
+		func(policy, expectedErr string) {
+			err := ValidateAuditPolicy(policy)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(expectedErr))
+		},
+		Entry("empty", "", "audit policy is empty"),
+		Entry("bad YAML", "apiVersion: audit.k8s.io/v1\nkind: [Policy", "could not be parsed"),
+		Entry("unknown field", "apiVersion: audit.k8s.io/v1\nkind: Policy\nbogus: true\n", "could not be parsed"),
+		Entry("wrong apiVersion", "apiVersion: audit.k8s.io/v1beta1\nkind: Policy\n", "must have apiVersion audit.k8s.io/v1"),
+		Entry("wrong kind", "apiVersion: audit.k8s.io/v1\nkind: ConfigMap\n", "kind Policy"),
+		Entry("invalid rule level", "apiVersion: audit.k8s.io/v1\nkind: Policy\nrules:\n- level: Everything\n", `invalid level "Everything"`),
+	)
+
+	It("should accept a valid audit policy", func() {
+		Expect(ValidateAuditPolicy("apiVersion: audit.k8s.io/v1\nkind: Policy\nrules:\n- level: Metadata\n- level: RequestResponse\n  verbs: [\"create\"]\n")).To(Succeed())
+	})
+})
@@ -31,6 +31,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
@@ -158,6 +159,12 @@ func add(c ctrlruntime.Controller, r *ReconcileAPIServer) error {
 		if err != nil {
 			return fmt.Errorf("apiserver-controller failed to watch resource: %w", err)
 		}
+
+		// The audit policy ConfigMap is named in the APIServer spec, so only react to the ConfigMap it currently names.
+		// Changing the name updates the APIServer, which triggers a reconcile of its own.
+		if err = c.WatchObject(&corev1.ConfigMap{}, &handler.EnqueueRequestForObject{}, predicate.NewPredicateFuncs(r.isAuditPolicyConfigMap)); err != nil {
+			return fmt.Errorf("apiserver-controller failed to watch ConfigMaps: %w", err)
+		}
 	}
 
 	// Watch for the namespace(s) managed by this controller.
@@ -188,6 +195,19 @@ func add(c ctrlruntime.Controller, r *ReconcileAPIServer) error {
 	return nil
 }
 
+// isAuditPolicyConfigMap returns true if obj is the audit policy ConfigMap named in the APIServer spec.
+func (r *ReconcileAPIServer) isAuditPolicyConfigMap(obj client.Object) bool {
+	if obj.GetNamespace() != common.OperatorNamespace() {
+		return false
+	}
+	instance, _, err := utils.GetAPIServer(context.Background(), r.client)
+	if err != nil {
+		return false
+	}
+	al := instance.Spec.AuditLogging
+	return al != nil && al.PolicyConfigMapName != "" && al.PolicyConfigMapName == obj.GetName()
+}
+
 // blank assignment to verify that ReconcileAPIServer implements reconcile.Reconciler
 var _ reconcile.Reconciler = &ReconcileAPIServer{}
 
@@ -296,8 +316,28 @@ func (r *ReconcileAPIServer) Reconcile(ctx context.Context, request reconcile.Re
 	var amazon *operatorv1.AmazonCloudIntegration
 	var managementCluster *operatorv1.ManagementCluster
 	var managementClusterConnection *operatorv1.ManagementClusterConnection
+	var auditPolicy string
 	includeV3NetworkPolicy := false
 	if variant == operatorv1.TigeraSecureEnterprise {
+		// The policy is only rendered when audit logging is enabled, so don't require it otherwise.
+		if al := instance.Spec.AuditLogging; al != nil && al.PolicyConfigMapName != "" &&
+			(al.Output == nil || *al.Output != operatorv1.APIServerAuditLogOutputDisabled) {
+			cm := &corev1.ConfigMap{}
+			if err := r.client.Get(ctx, types.NamespacedName{Name: al.PolicyConfigMapName, Namespace: common.OperatorNamespace()}, cm); err != nil {
+				if errors.IsNotFound(err) {
+					r.status.SetDegraded(operatorv1.ResourceNotFound, "Audit policy ConfigMap not found", err, reqLogger)
+					return reconcile.Result{}, nil
+				}
+				r.status.SetDegraded(operatorv1.ResourceReadError, "Error reading audit policy ConfigMap", err, reqLogger)
+				return reconcile.Result{}, err
+			}
+			auditPolicy = cm.Data["config"]
+			if err := apiserver.ValidateAuditPolicy(auditPolicy); err != nil {
+				r.status.SetDegraded(operatorv1.ResourceValidationError, fmt.Sprintf("ConfigMap %s does not contain a valid audit policy", al.PolicyConfigMapName), err, reqLogger)
+				return reconcile.Result{}, nil
+			}
+		}
+
 		managementCluster, err = utils.GetManagementCluster(ctx, r.client)
 		if err != nil {
 			r.status.SetDegraded(operatorv1.ResourceReadError, "Error reading ManagementCluster", err, reqLogger)
@@ -404,6 +444,7 @@ func (r *ReconcileAPIServer) Reconcile(ctx context.Context, request reconcile.Re
 		TrustedBundle:               trustedBundle,
 		UsePSP:                      r.usePSP,
 		MultiTenant:                 r.multiTenant,
+		AuditPolicy:                 auditPolicy,
 	}
 
 	component, err := render.APIServer(&apiServerCfg)
@@ -464,6 +464,94 @@ var _ = Describe("apiserver controller tests", func() {
 		})
 	})
 
+	Context("audit policy", func() {
+		var r ReconcileAPIServer
+
+		BeforeEach(func() {
+			Expect(cli.Create(ctx, installation)).To(BeNil())
+
+			apiServer := &operatorv1.APIServer{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "tigera-secure"}, apiServer)).NotTo(HaveOccurred())
+			apiServer.Spec.AuditLogging = &operatorv1.APIServerAuditLogging{PolicyConfigMapName: "my-audit-policy"}
+			Expect(cli.Update(ctx, apiServer)).NotTo(HaveOccurred())
+
+			r = ReconcileAPIServer{
+				client:              cli,
+				scheme:              scheme,
+				provider:            operatorv1.ProviderNone,
+				enterpriseCRDsExist: true,
+				status:              mockStatus,
+				tierWatchReady:      ready,
+			}
+		})
+
+		createPolicy := func(policy string) {
+			Expect(cli.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-audit-policy", Namespace: common.OperatorNamespace()},
+				Data:       map[string]string{"config": policy},
+			})).NotTo(HaveOccurred())
+		}
+
+		It("should render a user-supplied audit policy", func() {
+			policy := "apiVersion: audit.k8s.io/v1\nkind: Policy\nrules:\n- level: Metadata\n"
+			createPolicy(policy)
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			cm := &corev1.ConfigMap{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "tigera-audit-policy", Namespace: "tigera-system"}, cm)).NotTo(HaveOccurred())
+			Expect(cm.Data).To(Equal(map[string]string{"config": policy}))
+		})
+
+		It("should degrade when the audit policy ConfigMap does not exist", func() {
+			mockStatus.On("SetDegraded", operatorv1.ResourceNotFound, "Audit policy ConfigMap not found", mock.Anything, mock.Anything).Return()
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceNotFound, "Audit policy ConfigMap not found", mock.Anything, mock.Anything)
+		})
+
+		It("should not require the audit policy ConfigMap when audit logging is disabled", func() {
+			apiServer := &operatorv1.APIServer{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "tigera-secure"}, apiServer)).NotTo(HaveOccurred())
+			disabled := operatorv1.APIServerAuditLogOutputDisabled
+			apiServer.Spec.AuditLogging.Output = &disabled
+			Expect(cli.Update(ctx, apiServer)).NotTo(HaveOccurred())
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			mockStatus.AssertNotCalled(GinkgoT(), "SetDegraded", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+			deploy := &appsv1.Deployment{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "tigera-apiserver", Namespace: "tigera-system"}, deploy)).NotTo(HaveOccurred())
+		})
+
+		It("should only watch the audit policy ConfigMap named in the spec", func() {
+			namedCM := func(name, namespace string) *corev1.ConfigMap {
+				return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+			}
+			Expect(r.isAuditPolicyConfigMap(namedCM("my-audit-policy", common.OperatorNamespace()))).To(BeTrue())
+			Expect(r.isAuditPolicyConfigMap(namedCM("other", common.OperatorNamespace()))).To(BeFalse())
+			Expect(r.isAuditPolicyConfigMap(namedCM("my-audit-policy", "tigera-system"))).To(BeFalse())
+
+			apiServer := &operatorv1.APIServer{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "tigera-secure"}, apiServer)).NotTo(HaveOccurred())
+			apiServer.Spec.AuditLogging = nil
+			Expect(cli.Update(ctx, apiServer)).NotTo(HaveOccurred())
+			Expect(r.isAuditPolicyConfigMap(namedCM("my-audit-policy", common.OperatorNamespace()))).To(BeFalse())
+		})
+
+		It("should degrade when the audit policy is not valid", func() {
+			mockStatus.On("SetDegraded", operatorv1.ResourceValidationError, "ConfigMap my-audit-policy does not contain a valid audit policy", mock.Anything, mock.Anything).Return()
+			createPolicy("apiVersion: v1\nkind: ConfigMap\n")
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceValidationError, "ConfigMap my-audit-policy does not contain a valid audit policy", mock.Anything, mock.Anything)
+		})
+	})
+
 	Context("Reconcile for Condition status", func() {
 		generation := int64(2)
 		BeforeEach(func() {
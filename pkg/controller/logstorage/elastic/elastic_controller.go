@@ -53,6 +53,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -68,12 +69,22 @@ const (
 	LogStorageFinalizer = "tigera.io/eck-cleanup"
 )
 
+// Reasons used for the Warning events recorded on the LogStorage CR when reconciliation fails.
+const (
+	EventReasonStorageClassNotFound   = "StorageClassNotFound"
+	EventReasonInvalidImageSet        = "InvalidImageSet"
+	EventReasonResourceUpdateFailed   = "ResourceUpdateFailed"
+	EventReasonElasticsearchUnhealthy = "ElasticsearchUnhealthy"
+	EventReasonILMPolicyFailed        = "ILMPolicyFailed"
+)
+
 // ElasticSubController is a sub-controller of the main LogStorage controller
 // responsible for managing the Elasticsearch service used by Calico.
 type ElasticSubController struct {
 	client         client.Client
 	scheme         *runtime.Scheme
 	status         status.StatusManager
+	recorder       record.EventRecorder
 	provider       operatorv1.Provider
 	esCliCreator   utils.ElasticsearchClientCreator
 	clusterDomain  string
@@ -96,9 +107,10 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 	r := &ElasticSubController{
 		client:         mgr.GetClient(),
 		scheme:         mgr.GetScheme(),
-		esCliCreator:   utils.NewElasticClient,
+		esCliCreator:   utils.NewElasticClientCreator(opts.ESRequestTimeout, opts.ESRequestRetries),
 		tierWatchReady: &utils.ReadyFlag{},
 		status:         status.New(mgr.GetClient(), initializer.TigeraStatusLogStorageElastic, opts.KubernetesVersion),
+		recorder:       mgr.GetEventRecorderFor("log-storage-elastic-controller"),
 		usePSP:         opts.UsePSP,
 		clusterDomain:  opts.ClusterDomain,
 		provider:       opts.DetectedProvider,
@@ -396,6 +408,7 @@ func (r *ElasticSubController) Reconcile(ctx context.Context, request reconcile.
 		if errors.IsNotFound(err) {
 			err := fmt.Errorf("couldn't find storage class %s, this must be provided", ls.Spec.StorageClassName)
 			r.status.SetDegraded(operatorv1.ResourceNotFound, "Failed to get storage class", err, reqLogger)
+			r.recorder.Event(ls, corev1.EventTypeWarning, EventReasonStorageClassNotFound, err.Error())
 			return reconcile.Result{}, nil
 		}
 		r.status.SetDegraded(operatorv1.ResourceReadError, "Failed to get storage class", err, reqLogger)
@@ -547,11 +560,13 @@ func (r *ElasticSubController) Reconcile(ctx context.Context, request reconcile.
 	component := render.LogStorage(logStorageCfg)
 	if err = imageset.ApplyImageSet(ctx, r.client, variant, component); err != nil {
 		r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error with images from ImageSet", err, reqLogger)
+		r.recorder.Event(ls, corev1.EventTypeWarning, EventReasonInvalidImageSet, err.Error())
 		return reconcile.Result{}, err
 	}
 
 	if err := hdler.CreateOrUpdateOrDelete(ctx, component, r.status); err != nil {
 		r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error creating / updating resource", err, reqLogger)
+		r.recorder.Event(ls, corev1.EventTypeWarning, EventReasonResourceUpdateFailed, err.Error())
 		return reconcile.Result{}, err
 	}
 
@@ -559,6 +574,13 @@ func (r *ElasticSubController) Reconcile(ctx context.Context, request reconcile.
 		r.status.SetDegraded(operatorv1.ResourceNotReady, "Waiting for Elasticsearch cluster to be operational", nil, reqLogger)
 		return reconcile.Result{}, nil
 	}
+	if elasticsearch.Status.Health == esv1.ElasticsearchRedHealth {
+		// A red cluster has unassigned primary shards, even if ECK reports it as Ready, so degrade rather than
+		// reporting LogStorage as healthy, and surface it as an event so it shows up on the LogStorage itself.
+		r.status.SetDegraded(operatorv1.ResourceNotReady, "Elasticsearch cluster health is red", nil, reqLogger)
+		r.recorder.Event(ls, corev1.EventTypeWarning, EventReasonElasticsearchUnhealthy, "Elasticsearch cluster health is red")
+		return reconcile.Result{}, nil
+	}
 
 	if kibanaEnabled && kibana == nil {
 		r.status.SetDegraded(operatorv1.ResourceNotReady, "Waiting for Kibana cluster to be created", nil, reqLogger)
@@ -572,6 +594,7 @@ func (r *ElasticSubController) Reconcile(ctx context.Context, request reconcile.
 	if !r.multiTenant {
 		if err := r.applyILMPolicies(ls, reqLogger, ctx); err != nil {
 			r.status.SetDegraded(operatorv1.ResourceNotReady, "Error applying ILM policies", nil, reqLogger)
+			r.recorder.Event(ls, corev1.EventTypeWarning, EventReasonILMPolicyFailed, err.Error())
 			return reconcile.Result{}, err
 		}
 	}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 
 	"github.com/stretchr/testify/mock"
@@ -37,6 +38,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -97,6 +99,7 @@ func NewReconcilerWithShims(
 		esCliCreator:   esCliCreator,
 		tierWatchReady: tierWatchReady,
 		status:         status,
+		recorder:       &record.FakeRecorder{},
 		usePSP:         opts.UsePSP,
 		clusterDomain:  opts.ClusterDomain,
 		provider:       opts.DetectedProvider,
@@ -979,6 +982,131 @@ var _ = Describe("LogStorage controller", func() {
 				})
 			})
 
+			Context("recording events", func() {
+				var recorder *record.FakeRecorder
+
+				BeforeEach(func() {
+					recorder = record.NewFakeRecorder(10)
+
+					CreateLogStorage(cli, &operatorv1.LogStorage{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "tigera-secure",
+						},
+						Spec: operatorv1.LogStorageSpec{
+							Nodes: &operatorv1.Nodes{
+								Count: int64(1),
+							},
+							StorageClassName: storageClassName,
+						},
+						Status: operatorv1.LogStorageStatus{
+							State: operatorv1.TigeraStatusReady,
+						},
+					})
+				})
+
+				It("should record a warning event when the storage class is missing", func() {
+					mockStatus.On("SetDegraded", operatorv1.ResourceNotFound, "Failed to get storage class", mock.Anything, mock.Anything).Return()
+
+					r, err := NewReconcilerWithShims(cli, scheme, mockStatus, operatorv1.ProviderNone, MockESCLICreator, dns.DefaultClusterDomain, readyFlag)
+					Expect(err).ShouldNot(HaveOccurred())
+					r.recorder = recorder
+
+					_, err = r.Reconcile(ctx, reconcile.Request{})
+					Expect(err).ShouldNot(HaveOccurred())
+
+					Expect(recorder.Events).To(Receive(Equal(fmt.Sprintf("Warning %s couldn't find storage class %s, this must be provided",
+						EventReasonStorageClassNotFound, storageClassName))))
+					Expect(recorder.Events).NotTo(Receive())
+				})
+
+				DescribeTable("Elasticsearch with red health",
+					func(phase esv1.ElasticsearchOrchestrationPhase, expectedMessage string, expectedEvent bool) {
+						mockStatus.On("SetDegraded", operatorv1.ResourceNotReady, expectedMessage, mock.Anything, mock.Anything).Return()
+
+						Expect(cli.Create(ctx, &storagev1.StorageClass{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: storageClassName,
+							},
+						})).ShouldNot(HaveOccurred())
+						Expect(cli.Create(ctx, &esv1.Elasticsearch{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      render.ElasticsearchName,
+								Namespace: render.ElasticsearchNamespace,
+							},
+							Status: esv1.ElasticsearchStatus{
+								Phase:  phase,
+								Health: esv1.ElasticsearchRedHealth,
+							},
+						})).ShouldNot(HaveOccurred())
+
+						r, err := NewReconcilerWithShims(cli, scheme, mockStatus, operatorv1.ProviderNone, MockESCLICreator, dns.DefaultClusterDomain, readyFlag)
+						Expect(err).ShouldNot(HaveOccurred())
+						r.recorder = recorder
+
+						_, err = r.Reconcile(ctx, reconcile.Request{})
+						Expect(err).ShouldNot(HaveOccurred())
+
+						if expectedEvent {
+							Expect(recorder.Events).To(Receive(Equal(fmt.Sprintf("Warning %s Elasticsearch cluster health is red", EventReasonElasticsearchUnhealthy))))
+						} else {
+							Expect(recorder.Events).NotTo(Receive())
+						}
+						mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceNotReady, expectedMessage, mock.Anything, mock.Anything)
+						mockStatus.AssertNotCalled(GinkgoT(), "ClearDegraded")
+					},
+					Entry("should keep waiting without a warning event while changes are being applied",
+						esv1.ElasticsearchApplyingChangesPhase, "Waiting for Elasticsearch cluster to be operational", false),
+					Entry("should degrade and record a warning event once the cluster is Ready",
+						esv1.ElasticsearchReadyPhase, "Elasticsearch cluster health is red", true),
+				)
+
+				It("should not record events when reconcile succeeds", func() {
+					mockStatus.On("ClearDegraded", mock.Anything)
+
+					resources := []client.Object{
+						&storagev1.StorageClass{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: storageClassName,
+							},
+						},
+						&esv1.Elasticsearch{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      render.ElasticsearchName,
+								Namespace: render.ElasticsearchNamespace,
+							},
+							Status: esv1.ElasticsearchStatus{
+								Phase:  esv1.ElasticsearchReadyPhase,
+								Health: esv1.ElasticsearchGreenHealth,
+							},
+						},
+						&kbv1.Kibana{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      render.KibanaName,
+								Namespace: render.KibanaNamespace,
+							},
+							Status: kbv1.KibanaStatus{
+								AssociationStatus: cmnv1.AssociationEstablished,
+							},
+						},
+						&corev1.ConfigMap{
+							ObjectMeta: metav1.ObjectMeta{Namespace: render.ECKOperatorNamespace, Name: render.ECKLicenseConfigMapName},
+							Data:       map[string]string{"eck_license_level": string(render.ElasticsearchLicenseTypeEnterprise)},
+						},
+					}
+					for _, rec := range resources {
+						Expect(cli.Create(ctx, rec)).ShouldNot(HaveOccurred())
+					}
+
+					r, err := NewReconcilerWithShims(cli, scheme, mockStatus, operatorv1.ProviderNone, MockESCLICreator, dns.DefaultClusterDomain, readyFlag)
+					Expect(err).ShouldNot(HaveOccurred())
+					r.recorder = recorder
+
+					_, err = r.Reconcile(ctx, reconcile.Request{})
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(recorder.Events).NotTo(Receive())
+				})
+			})
+
 			Context("allow-tigera rendering", func() {
 				var r reconcile.Reconciler
 				BeforeEach(func() {
@@ -84,7 +84,7 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 		scheme:          mgr.GetScheme(),
 		multiTenant:     opts.MultiTenant,
 		status:          status.New(mgr.GetClient(), initializer.TigeraStatusLogStorageUsers, opts.KubernetesVersion),
-		esClientFn:      utils.NewElasticClient,
+		esClientFn:      utils.NewElasticClientCreator(opts.ESRequestTimeout, opts.ESRequestRetries),
 		elasticExternal: opts.ElasticExternal,
 	}
 	r.status.Run(opts.ShutdownContext)
@@ -137,7 +137,7 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 	usersCleanupReconciler := &UsersCleanupController{
 		client:          mgr.GetClient(),
 		scheme:          mgr.GetScheme(),
-		esClientFn:      utils.NewElasticClient,
+		esClientFn:      utils.NewElasticClientCreator(opts.ESRequestTimeout, opts.ESRequestRetries),
 		elasticExternal: opts.ElasticExternal,
 	}
 
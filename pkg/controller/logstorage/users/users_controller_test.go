@@ -16,22 +16,32 @@ package users
 
 import (
 	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
 
 	apiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/common"
 	tigeraelastic "github.com/tigera/operator/pkg/controller/logstorage/elastic"
+	"github.com/tigera/operator/pkg/controller/status"
 	"github.com/tigera/operator/pkg/controller/utils"
 	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+	"github.com/tigera/operator/pkg/render"
 )
 
 var _ = Describe("LogStorage cleanup controller", func() {
@@ -110,3 +120,89 @@ var _ = Describe("LogStorage cleanup controller", func() {
 		Expect(testESClient.AssertExpectations(t))
 	})
 })
+
+var _ = Describe("LogStorage users controller", func() {
+	var (
+		cli        client.Client
+		scheme     *runtime.Scheme
+		ctx        context.Context
+		mockStatus *status.MockStatus
+		server     *httptest.Server
+		release    chan struct{}
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(operatorv1.AddToScheme(scheme)).NotTo(HaveOccurred())
+		Expect(corev1.AddToScheme(scheme)).NotTo(HaveOccurred())
+		cli = ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
+		ctx = context.Background()
+
+		// The server never answers until the test releases it, simulating an unresponsive Elasticsearch.
+		release = make(chan struct{})
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+
+		mockStatus = &status.MockStatus{}
+		mockStatus.On("AddDaemonsets", mock.Anything)
+		mockStatus.On("AddDeployments", mock.Anything)
+		mockStatus.On("AddStatefulSets", mock.Anything)
+		mockStatus.On("AddCronJobs", mock.Anything)
+		mockStatus.On("RemoveCertificateSigningRequests", mock.Anything).Return()
+		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("ReadyToMonitor")
+		mockStatus.On("SetDegraded", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	AfterEach(func() {
+		close(release)
+		server.Close()
+	})
+
+	It("should degrade once Elasticsearch requests time out", func() {
+		caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+		Expect(cli.Create(ctx, &operatorv1.Installation{
+			ObjectMeta: apiv1.ObjectMeta{Name: "default"},
+			Spec: operatorv1.InstallationSpec{
+				CertificateManagement: &operatorv1.CertificateManagement{CACert: caPEM},
+			},
+		})).NotTo(HaveOccurred())
+		Expect(cli.Create(ctx, &corev1.Secret{
+			ObjectMeta: apiv1.ObjectMeta{Name: render.ElasticsearchAdminUserSecret, Namespace: common.OperatorNamespace()},
+			Data:       map[string][]byte{"elastic": []byte("password")},
+		})).NotTo(HaveOccurred())
+		Expect(cli.Create(ctx, &operatorv1.LogStorage{
+			ObjectMeta: apiv1.ObjectMeta{Name: "tigera-secure"},
+			Status:     operatorv1.LogStorageStatus{State: operatorv1.TigeraStatusReady},
+		})).NotTo(HaveOccurred())
+		Expect(cli.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: apiv1.ObjectMeta{Name: "cluster-info", Namespace: "tigera-operator"},
+			Data:       map[string]string{"cluster-id": "cluster1"},
+		})).NotTo(HaveOccurred())
+		Expect(cli.Create(ctx, &operatorv1.Tenant{
+			ObjectMeta: apiv1.ObjectMeta{Name: "default", Namespace: "tenant-a"},
+			Spec: operatorv1.TenantSpec{
+				ID:      "tenant-a",
+				Elastic: &operatorv1.TenantElasticSpec{URL: server.URL},
+			},
+		})).NotTo(HaveOccurred())
+
+		r := UserController{
+			client:          cli,
+			scheme:          scheme,
+			status:          mockStatus,
+			esClientFn:      utils.NewElasticClientCreator(200*time.Millisecond, 1),
+			multiTenant:     true,
+			elasticExternal: true,
+		}
+
+		done := make(chan error)
+		go func() {
+			_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: "default", Namespace: "tenant-a"}})
+			done <- err
+		}()
+		Eventually(done, 5*time.Second).Should(Receive(HaveOccurred()))
+		mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceUpdateError, "Failed to create or update Elasticsearch user", mock.Anything, mock.Anything)
+	})
+})
@@ -16,6 +16,7 @@ package options
 
 import (
 	"context"
+	"time"
 
 	v1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/common"
@@ -46,4 +47,13 @@ type AddOptions struct {
 
 	// Whether or not the cluster supports PodSecurityPolicies.
 	UsePSP bool
+
+	// ESRequestTimeout bounds each request the operator makes to Elasticsearch (health checks, users, ILM
+	// policies), so that an unresponsive cluster degrades the LogStorage status instead of blocking
+	// reconciliation. Zero means no timeout.
+	ESRequestTimeout time.Duration
+
+	// ESRequestRetries is the number of attempts made at each Elasticsearch request that fails to connect or
+	// gets a gateway error back before giving up. Timed out requests are not retried. Zero uses the default.
+	ESRequestRetries int
 }
@@ -43,6 +43,7 @@ const (
 	DefaultMaxIndexSizeGi        = 30
 	ElasticConnRetries           = 10
 	ElasticConnRetryInterval     = "500ms"
+	ElasticRequestTimeout        = 30 * time.Second
 )
 
 type Policy struct {
@@ -116,7 +117,19 @@ type esClient struct {
 	client *elastic.Client
 }
 
-func NewElasticClient(client client.Client, ctx context.Context, elasticHTTPSEndpoint string) (ElasticClient, error) {
+// NewElasticClientCreator returns an ElasticsearchClientCreator whose clients give up on Elasticsearch requests that
+// take longer than timeout, and make up to retries attempts at each request that fails to connect or gets a gateway
+// error back. A zero timeout means requests never time out, and a non-positive retries uses ElasticConnRetries.
+func NewElasticClientCreator(timeout time.Duration, retries int) ElasticsearchClientCreator {
+	if retries <= 0 {
+		retries = ElasticConnRetries
+	}
+	return func(client client.Client, ctx context.Context, elasticHTTPSEndpoint string) (ElasticClient, error) {
+		return newElasticClient(client, ctx, elasticHTTPSEndpoint, timeout, retries)
+	}
+}
+
+func newElasticClient(client client.Client, ctx context.Context, elasticHTTPSEndpoint string, timeout time.Duration, retries int) (ElasticClient, error) {
 	user, password, root, err := getClientCredentials(client, ctx)
 	if err != nil {
 		return nil, err
@@ -135,32 +148,41 @@ func NewElasticClient(client client.Client, ctx context.Context, elasticHTTPSEnd
 	//   could be little more difficult and possibly error-prone, leading to a regression where we leak resources again.
 	h := &http.Client{
 		Transport: &http.Transport{DisableKeepAlives: true, TLSClientConfig: &tls.Config{RootCAs: root}},
+		Timeout:   timeout,
 	}
 
-	options := []elastic.ClientOptionFunc{
+	retryInterval, err := time.ParseDuration(ElasticConnRetryInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sniffing and health checks are disabled, so creating the client never reaches Elasticsearch. Retries are
+	// instead applied by the client to each request, for connection errors and the listed gateway errors.
+	esCli, err := elastic.NewClient(
 		elastic.SetURL(elasticHTTPSEndpoint),
 		elastic.SetHttpClient(h),
 		elastic.SetErrorLog(logrWrappedESLogger{}),
 		elastic.SetSniff(false),
 		elastic.SetHealthcheck(false),
 		elastic.SetBasicAuth(user, password),
-	}
-	retryInterval, err := time.ParseDuration(ElasticConnRetryInterval)
+		elastic.SetRetrier(elastic.NewBackoffRetrier(attemptsBackoff{interval: retryInterval, attempts: retries})),
+		elastic.SetRetryStatusCodes(http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout),
+	)
 	if err != nil {
 		return nil, err
 	}
+	return &esClient{client: esCli}, nil
+}
 
-	var esCli *elastic.Client
-	for i := 0; i < ElasticConnRetries; i++ {
-		esCli, err = elastic.NewClient(options...)
-		if err == nil {
-			break
-		}
-		log.Error(err, "Elastic connect failed, retrying")
-		time.Sleep(retryInterval)
-	}
+// attemptsBackoff waits a fixed interval between attempts at an Elasticsearch request and gives up once the request
+// has been attempted the configured number of times.
+type attemptsBackoff struct {
+	interval time.Duration
+	attempts int
+}
 
-	return &esClient{client: esCli}, err
+func (b attemptsBackoff) Next(retry int) (time.Duration, bool) {
+	return b.interval, retry < b.attempts
 }
 
 func formatName(name, clusterID, tenantID string) string {
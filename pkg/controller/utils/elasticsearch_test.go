@@ -17,18 +17,32 @@ package utils
 import (
 	"bytes"
 	"context"
+	"encoding/pem"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
 	elastic "github.com/olivere/elastic/v7"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/apis"
+	"github.com/tigera/operator/pkg/common"
+	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+	"github.com/tigera/operator/pkg/render"
 )
 
 const (
@@ -92,8 +106,113 @@ var _ = Describe("Elasticsearch tests", func() {
 			Expect(err).To(BeNil())
 		})
 	})
+
+	Context("client timeouts", func() {
+		var (
+			server  *httptest.Server
+			release chan struct{}
+			ctx     context.Context
+		)
+
+		BeforeEach(func() {
+			// The server never answers until the test releases it, simulating an unresponsive Elasticsearch.
+			release = make(chan struct{})
+			server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				<-release
+			}))
+			ctx = context.Background()
+		})
+
+		AfterEach(func() {
+			close(release)
+			server.Close()
+		})
+
+		It("returns an error once the configured timeout expires instead of blocking", func() {
+			cli := newTrustingClient(ctx, server)
+
+			esCli, err := NewElasticClientCreator(200*time.Millisecond, 1)(cli, ctx, server.URL)
+			Expect(err).NotTo(HaveOccurred())
+
+			done := make(chan error)
+			go func() {
+				_, err := esCli.GetUsers(ctx)
+				done <- err
+			}()
+			Eventually(done, 5*time.Second).Should(Receive(HaveOccurred()))
+		})
+	})
+
+	Context("client retries", func() {
+		var (
+			server   *httptest.Server
+			failures int32
+			requests int32
+			ctx      context.Context
+		)
+
+		BeforeEach(func() {
+			// The server answers the first failures requests as unavailable, simulating a flaky Elasticsearch.
+			failures, requests = 0, 0
+			server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&requests, 1) <= atomic.LoadInt32(&failures) {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte("{}"))
+			}))
+			ctx = context.Background()
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("returns an error when the only attempt fails", func() {
+			atomic.StoreInt32(&failures, 2)
+			esCli, err := NewElasticClientCreator(time.Second, 1)(newTrustingClient(ctx, server), ctx, server.URL)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = esCli.GetUsers(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(atomic.LoadInt32(&requests)).To(Equal(int32(1)))
+		})
+
+		It("succeeds once a retried attempt gets through", func() {
+			atomic.StoreInt32(&failures, 2)
+			esCli, err := NewElasticClientCreator(time.Second, 3)(newTrustingClient(ctx, server), ctx, server.URL)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = esCli.GetUsers(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(atomic.LoadInt32(&requests)).To(Equal(int32(3)))
+		})
+	})
 })
 
+// newTrustingClient returns a fake client holding the Installation CA and admin credentials that the Elasticsearch
+// client needs to talk to server.
+func newTrustingClient(ctx context.Context, server *httptest.Server) client.Client {
+	scheme := runtime.NewScheme()
+	Expect(apis.AddToScheme(scheme)).NotTo(HaveOccurred())
+	Expect(corev1.SchemeBuilder.AddToScheme(scheme)).NotTo(HaveOccurred())
+	cli := ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	Expect(cli.Create(ctx, &operatorv1.Installation{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: operatorv1.InstallationSpec{
+			CertificateManagement: &operatorv1.CertificateManagement{CACert: caPEM},
+		},
+	})).NotTo(HaveOccurred())
+	Expect(cli.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: render.ElasticsearchAdminUserSecret, Namespace: common.OperatorNamespace()},
+		Data:       map[string][]byte{"elastic": []byte("password")},
+	})).NotTo(HaveOccurred())
+	return cli
+}
+
 type testRoundTripper struct {
 	e error
 }
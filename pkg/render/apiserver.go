@@ -21,6 +21,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -49,6 +50,8 @@ const (
 
 	auditLogsVolumeName   = "tigera-audit-logs"
 	auditPolicyVolumeName = "tigera-audit-policy"
+
+	auditPolicyHashAnnotation = "hash.operator.tigera.io/audit-policy"
 )
 
 const (
@@ -120,6 +123,9 @@ type APIServerConfiguration struct {
 	TrustedBundle               certificatemanagement.TrustedBundle
 	MultiTenant                 bool
 
+	// AuditPolicy is a user-supplied audit.k8s.io/v1 Policy. If empty, the default audit policy is used.
+	AuditPolicy string
+
 	// Whether the cluster supports pod security policies.
 	UsePSP bool
 }
@@ -211,6 +217,14 @@ func (c *apiServerComponent) Objects() ([]client.Object, []client.Object) {
 		c.apiServerService(),
 	)
 
+	// Only protect the API server with a PodDisruptionBudget when there is more than one replica. A single
+	// replica cannot stay available during a disruption anyway, so the budget would not buy anything.
+	if c.highlyAvailable() {
+		namespacedObjects = append(namespacedObjects, c.apiServerPodDisruptionBudget())
+	} else {
+		objsToDelete = append(objsToDelete, c.apiServerPodDisruptionBudget())
+	}
+
 	// Add in certificates for API server TLS.
 	if !c.cfg.TLSKeyPair.UseCertificateManagement() {
 		globalObjects = append(globalObjects, c.apiServiceRegistration(c.cfg.TLSKeyPair.GetCertificatePEM()))
@@ -257,8 +271,11 @@ func (c *apiServerComponent) Objects() ([]client.Object, []client.Object) {
 	}
 
 	// Namespaced enterprise-only objects.
-	namespacedEnterpriseObjects := []client.Object{
-		c.auditPolicyConfigMap(),
+	namespacedEnterpriseObjects := []client.Object{}
+	if c.auditLoggingEnabled() {
+		namespacedEnterpriseObjects = append(namespacedEnterpriseObjects, c.auditPolicyConfigMap())
+	} else if c.cfg.Installation.Variant == operatorv1.TigeraSecureEnterprise {
+		objsToDelete = append(objsToDelete, c.auditPolicyConfigMap())
 	}
 	if c.cfg.TrustedBundle != nil {
 		namespacedEnterpriseObjects = append(namespacedEnterpriseObjects, c.cfg.TrustedBundle.ConfigMap(QueryserverNamespace))
@@ -934,15 +951,39 @@ func (c *apiServerComponent) apiServerService() *corev1.Service {
 	return s
 }
 
+// apiServerDeploymentName returns the name of the API server deployment for the installed variant.
+func (c *apiServerComponent) apiServerDeploymentName() string {
+	if c.cfg.Installation.Variant == operatorv1.TigeraSecureEnterprise {
+		return "tigera-apiserver"
+	}
+	return "calico-apiserver"
+}
+
+// highlyAvailable returns true if the API server is configured to run more than one replica.
+func (c *apiServerComponent) highlyAvailable() bool {
+	return c.cfg.Installation.ControlPlaneReplicas != nil && *c.cfg.Installation.ControlPlaneReplicas > 1
+}
+
+// apiServerPodDisruptionBudget keeps at least one API server replica available during voluntary disruptions,
+// so that aggregated API requests from kube-apiserver keep being served.
+func (c *apiServerComponent) apiServerPodDisruptionBudget() *policyv1.PodDisruptionBudget {
+	maxUnavailable := intstr.FromInt(1)
+	return &policyv1.PodDisruptionBudget{
+		TypeMeta: metav1.TypeMeta{Kind: "PodDisruptionBudget", APIVersion: "policy/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      c.apiServerDeploymentName(),
+			Namespace: rmeta.APIServerNamespace(c.cfg.Installation.Variant),
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MaxUnavailable: &maxUnavailable,
+			Selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"apiserver": "true"}},
+		},
+	}
+}
+
 // apiServer creates a deployment containing the API and query servers.
 func (c *apiServerComponent) apiServerDeployment() *appsv1.Deployment {
-	var name string
-	switch c.cfg.Installation.Variant {
-	case operatorv1.TigeraSecureEnterprise:
-		name = "tigera-apiserver"
-	case operatorv1.Calico:
-		name = "calico-apiserver"
-	}
+	name := c.apiServerDeploymentName()
 
 	hostNetwork := c.hostNetwork()
 	dnsPolicy := corev1.DNSClusterFirst
@@ -962,6 +1003,11 @@ func (c *apiServerComponent) apiServerDeployment() *appsv1.Deployment {
 	annotations := map[string]string{
 		c.cfg.TLSKeyPair.HashAnnotationKey(): c.cfg.TLSKeyPair.HashAnnotationValue(),
 	}
+	if c.auditLoggingEnabled() {
+		// The audit policy is only read on startup, so roll the pods whenever the policy changes, including
+		// when a user-supplied policy is removed in favour of the default.
+		annotations[auditPolicyHashAnnotation] = rmeta.AnnotationHash(c.auditPolicy())
+	}
 
 	d := &appsv1.Deployment{
 		TypeMeta: metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
@@ -1006,8 +1052,23 @@ func (c *apiServerComponent) apiServerDeployment() *appsv1.Deployment {
 		},
 	}
 
-	if c.cfg.Installation.ControlPlaneReplicas != nil && *c.cfg.Installation.ControlPlaneReplicas > 1 {
+	if c.highlyAvailable() {
 		d.Spec.Template.Spec.Affinity = podaffinity.NewPodAntiAffinity(name, rmeta.APIServerNamespace(c.cfg.Installation.Variant))
+
+		// With more than one replica, roll pods one at a time without taking any down first so that the
+		// aggregated API stays available during upgrades. Host-networked pods keep the Recreate strategy,
+		// since a surge pod cannot bind the API server port on a node that is already running one.
+		if !hostNetwork {
+			maxUnavailable := intstr.FromInt(0)
+			maxSurge := intstr.FromInt(1)
+			d.Spec.Strategy = appsv1.DeploymentStrategy{
+				Type: appsv1.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDeployment{
+					MaxUnavailable: &maxUnavailable,
+					MaxSurge:       &maxSurge,
+				},
+			}
+		}
 	}
 
 	if c.cfg.Installation.Variant == operatorv1.TigeraSecureEnterprise {
@@ -1046,7 +1107,7 @@ func (c *apiServerComponent) apiServerContainer() corev1.Container {
 	volumeMounts := []corev1.VolumeMount{
 		c.cfg.TLSKeyPair.VolumeMount(c.SupportedOSType()),
 	}
-	if c.cfg.Installation.Variant == operatorv1.TigeraSecureEnterprise {
+	if c.auditLoggingEnabled() {
 		volumeMounts = append(volumeMounts,
 			corev1.VolumeMount{Name: auditLogsVolumeName, MountPath: "/var/log/calico/audit"},
 			corev1.VolumeMount{Name: auditPolicyVolumeName, MountPath: "/etc/tigera/audit"},
@@ -1101,7 +1162,7 @@ func (c *apiServerComponent) startUpArgs() []string {
 		fmt.Sprintf("--tls-cert-file=%s", c.cfg.TLSKeyPair.VolumeMountCertificateFilePath()),
 	}
 
-	if c.cfg.Installation.Variant == operatorv1.TigeraSecureEnterprise {
+	if c.auditLoggingEnabled() {
 		args = append(args,
 			"--audit-policy-file=/etc/tigera/audit/policy.conf",
 			"--audit-log-path=/var/log/calico/audit/tsee-audit.log",
@@ -1177,17 +1238,21 @@ func (c *apiServerComponent) apiServerVolumes() []corev1.Volume {
 	volumes := []corev1.Volume{
 		c.cfg.TLSKeyPair.Volume(),
 	}
-	hostPathType := corev1.HostPathDirectoryOrCreate
-	if c.cfg.Installation.Variant == operatorv1.TigeraSecureEnterprise {
+	if c.auditLoggingEnabled() {
+		auditLogsVolumeSource := corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}
+		if c.auditLogOutput() == operatorv1.APIServerAuditLogOutputHostPath {
+			hostPathType := corev1.HostPathDirectoryOrCreate
+			auditLogsVolumeSource = corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: "/var/log/calico/audit",
+					Type: &hostPathType,
+				},
+			}
+		}
 		volumes = append(volumes,
 			corev1.Volume{
-				Name: auditLogsVolumeName,
-				VolumeSource: corev1.VolumeSource{
-					HostPath: &corev1.HostPathVolumeSource{
-						Path: "/var/log/calico/audit",
-						Type: &hostPathType,
-					},
-				},
+				Name:         auditLogsVolumeName,
+				VolumeSource: auditLogsVolumeSource,
 			},
 			corev1.Volume{
 				Name: auditPolicyVolumeName,
@@ -1204,7 +1269,9 @@ func (c *apiServerComponent) apiServerVolumes() []corev1.Volume {
 				},
 			},
 		)
+	}
 
+	if c.cfg.Installation.Variant == operatorv1.TigeraSecureEnterprise {
 		if c.cfg.TrustedBundle != nil {
 			volumes = append(volumes, c.cfg.TrustedBundle.Volume())
 		}
@@ -1916,12 +1983,50 @@ func (c *apiServerComponent) uiSettingsPassthruClusterRolebinding() *rbacv1.Clus
 	}
 }
 
+// auditLogOutput returns where the API server should write its audit logs.
+func (c *apiServerComponent) auditLogOutput() operatorv1.APIServerAuditLogOutput {
+	if c.cfg.APIServer != nil && c.cfg.APIServer.AuditLogging != nil && c.cfg.APIServer.AuditLogging.Output != nil {
+		return *c.cfg.APIServer.AuditLogging.Output
+	}
+	return operatorv1.APIServerAuditLogOutputHostPath
+}
+
+// auditLoggingEnabled returns true if the API server should be configured to write audit logs.
+//
+// Calico Enterprise only
+func (c *apiServerComponent) auditLoggingEnabled() bool {
+	return c.cfg.Installation.Variant == operatorv1.TigeraSecureEnterprise &&
+		c.auditLogOutput() != operatorv1.APIServerAuditLogOutputDisabled
+}
+
+// auditPolicy returns the audit policy to configure the API server with, falling back to the
+// default policy if the user has not supplied one.
+func (c *apiServerComponent) auditPolicy() string {
+	if c.cfg.AuditPolicy != "" {
+		return c.cfg.AuditPolicy
+	}
+	return defaultAuditPolicy
+}
+
 // auditPolicyConfigMap returns a configmap with contents to configure audit logging for
 // projectcalico.org/v3 APIs.
 //
 // Calico Enterprise only
 func (c *apiServerComponent) auditPolicyConfigMap() *corev1.ConfigMap {
-	const defaultAuditPolicy = `apiVersion: audit.k8s.io/v1
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			// This object is for Enterprise only, so pass it explicitly.
+			Namespace: rmeta.APIServerNamespace(operatorv1.TigeraSecureEnterprise),
+			Name:      auditPolicyVolumeName,
+		},
+		Data: map[string]string{
+			"config": c.auditPolicy(),
+		},
+	}
+}
+
+const defaultAuditPolicy = `apiVersion: audit.k8s.io/v1
 kind: Policy
 rules:
 - level: RequestResponse
@@ -1944,16 +2049,3 @@ rules:
     - networksets
     - tiers
     - hostendpoints`
-
-	return &corev1.ConfigMap{
-		TypeMeta: metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
-		ObjectMeta: metav1.ObjectMeta{
-			// This object is for Enterprise only, so pass it explicitly.
-			Namespace: rmeta.APIServerNamespace(operatorv1.TigeraSecureEnterprise),
-			Name:      auditPolicyVolumeName,
-		},
-		Data: map[string]string{
-			"config": defaultAuditPolicy,
-		},
-	}
-}